@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+// Config contains options used to customize the behaviour of a Driver.
+// Configurers are created with functions such as LegacyTimeTimeEncoding
+// below and passed to NewDriver.
+type Config struct {
+	// LegacyTimeTimeEncoding, when true, restores the pre-ZonedDateTime
+	// encoding behaviour: a bare time.Time parameter is sent as a DATETIME
+	// with a named time zone whenever its *time.Location appears to carry
+	// an IANA name, and as a fixed-offset DATETIME otherwise. When false
+	// (the default), a bare time.Time is always sent as a fixed-offset
+	// DATETIME; use AsZonedDateTime to opt into a named zone explicitly.
+	LegacyTimeTimeEncoding bool
+}
+
+// LegacyTimeTimeEncoding configures whether a bare time.Time Cypher
+// parameter keeps using the pre-ZonedDateTime heuristic for picking its
+// wire type, or is always encoded as a fixed-offset DATETIME. Set this to
+// true to keep existing applications working unmodified while they migrate
+// call sites to AsZonedDateTime, AsOffsetDateTime and AsLocalDateTime.
+func LegacyTimeTimeEncoding(enabled bool) func(*Config) {
+	return func(config *Config) {
+		config.LegacyTimeTimeEncoding = enabled
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date represents a Neo4j DATE value: a year, month and day without a time
+// component or time zone.
+type Date struct {
+	date time.Time
+}
+
+// DateOf creates a Date from the year, month and day components of the
+// given time.Time. The time-of-day and location of t are ignored. The
+// result is stored at midnight in time.UTC rather than t's own location:
+// Date carries no time zone of its own, and UTC, unlike most local zones,
+// never has a daylight-saving gap or ambiguous wall-clock reading that
+// could otherwise silently shift a date's civil fields.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// Time returns this date as a time.Time set to midnight UTC.
+func (d Date) Time() time.Time {
+	return d.date
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.date.Year(), d.date.Month(), d.date.Day())
+}
+
+// Plus returns the Date obtained by adding dur to d, applying its months,
+// then days, then seconds and nanoseconds components in that order, the
+// same order Neo4j's Cypher `+` operator uses. Any seconds or nanoseconds
+// in dur that carry past midnight still only affect the date, not a time
+// component, since Date has none.
+func (d Date) Plus(dur Duration) Date {
+	return DateOf(addDuration(d.date, dur))
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Duration represents a temporal amount containing months, days, seconds and
+// nanoseconds, matching the components of Neo4j's DURATION type. It is kept
+// separate from time.Duration because months and days are not a fixed
+// number of nanoseconds (a month can be 28-31 days, a day can include a
+// daylight-saving transition).
+type Duration struct {
+	months  int64
+	days    int64
+	seconds int64
+	nanos   int
+}
+
+// DurationOf creates a Duration from the given months, days, seconds and
+// nanoseconds components, as received over the wire. Overflow of seconds
+// into days, or days into months, is deliberately not performed here: Neo4j
+// keeps the components separate and so does this type.
+func DurationOf(months, days, seconds int64, nanos int) Duration {
+	return Duration{months: months, days: days, seconds: seconds, nanos: nanos}
+}
+
+// Months returns the number of months in this duration.
+func (d Duration) Months() int64 {
+	return d.months
+}
+
+// Days returns the number of days in this duration.
+func (d Duration) Days() int64 {
+	return d.days
+}
+
+// Seconds returns the number of seconds in this duration.
+func (d Duration) Seconds() int64 {
+	return d.seconds
+}
+
+// Nanos returns the number of nanoseconds within the last second of this
+// duration.
+func (d Duration) Nanos() int {
+	return d.nanos
+}
+
+func (d Duration) String() string {
+	return fmt.Sprintf("Duration{months: %d, days: %d, seconds: %d, nanos: %d}", d.months, d.days, d.seconds, d.nanos)
+}
+
+// Add returns the component-wise sum of d and other: months and days add
+// directly, while seconds and nanos are added and then normalized so that
+// nanos stays within [0, 1e9), matching the representation Neo4j sends over
+// the wire. Months and days are never carried into one another, nor into
+// seconds, since Neo4j deliberately keeps them separate: a month is not a
+// fixed number of days, nor a day a fixed number of seconds.
+func (d Duration) Add(other Duration) Duration {
+	seconds, nanos := normalizeSecondsNanos(d.seconds+other.seconds, d.nanos+other.nanos)
+	return Duration{
+		months:  d.months + other.months,
+		days:    d.days + other.days,
+		seconds: seconds,
+		nanos:   nanos,
+	}
+}
+
+// Neg returns d with every component negated.
+func (d Duration) Neg() Duration {
+	seconds, nanos := normalizeSecondsNanos(-d.seconds, -d.nanos)
+	return Duration{
+		months:  -d.months,
+		days:    -d.days,
+		seconds: seconds,
+		nanos:   nanos,
+	}
+}
+
+// ToTimeDuration converts d to a time.Duration. It returns false if d has a
+// nonzero months or days component, since those are not a fixed number of
+// nanoseconds and so cannot be represented by time.Duration without first
+// anchoring d to a specific instant, or if d.seconds is large enough that
+// the equivalent nanosecond count would overflow time.Duration's int64
+// range.
+func (d Duration) ToTimeDuration() (time.Duration, bool) {
+	if d.months != 0 || d.days != 0 {
+		return 0, false
+	}
+
+	const nanosPerSecond = int64(time.Second)
+	maxSeconds := int64(math.MaxInt64) / nanosPerSecond
+	minSeconds := int64(math.MinInt64) / nanosPerSecond
+
+	if d.seconds > maxSeconds || (d.seconds == maxSeconds && int64(d.nanos) > math.MaxInt64-maxSeconds*nanosPerSecond) {
+		return 0, false
+	}
+	if d.seconds < minSeconds {
+		return 0, false
+	}
+
+	return time.Duration(d.seconds*nanosPerSecond + int64(d.nanos)), true
+}
+
+// FromTimeDuration converts a time.Duration to a Duration with zero months
+// and days.
+func FromTimeDuration(d time.Duration) Duration {
+	seconds, nanos := normalizeSecondsNanos(int64(d/time.Second), int(d%time.Second))
+	return Duration{seconds: seconds, nanos: nanos}
+}
+
+// normalizeSecondsNanos carries nanos into seconds so that the returned
+// nanos always lies within [0, 1e9), regardless of the sign of the inputs.
+func normalizeSecondsNanos(seconds int64, nanos int) (int64, int) {
+	for nanos < 0 {
+		nanos += 1e9
+		seconds--
+	}
+	for nanos >= 1e9 {
+		nanos -= 1e9
+		seconds++
+	}
+	return seconds, nanos
+}
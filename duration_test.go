@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationAdd(t *testing.T) {
+	a := DurationOf(1, 2, 3, 700000000)
+	b := DurationOf(1, 1, 1, 500000000)
+
+	got := a.Add(b)
+	want := DurationOf(2, 3, 5, 200000000)
+	if got != want {
+		t.Fatalf("a.Add(b) = %v, want %v", got, want)
+	}
+}
+
+func TestDurationNeg(t *testing.T) {
+	got := DurationOf(1, -2, 3, 400000000).Neg()
+	want := DurationOf(-1, 2, -3, -400000000)
+	wantSeconds, wantNanos := normalizeSecondsNanos(want.seconds, want.nanos)
+	if got.months != -1 || got.days != 2 || got.seconds != wantSeconds || got.nanos != wantNanos {
+		t.Fatalf("Neg() = %v, want months=-1 days=2 seconds=%d nanos=%d", got, wantSeconds, wantNanos)
+	}
+}
+
+func TestDurationToTimeDuration(t *testing.T) {
+	d := DurationOf(0, 0, 90, 500000000)
+	got, ok := d.ToTimeDuration()
+	if !ok {
+		t.Fatalf("expected ToTimeDuration to succeed for a duration with no months or days")
+	}
+	if want := 90*time.Second + 500*time.Millisecond; got != want {
+		t.Fatalf("ToTimeDuration() = %v, want %v", got, want)
+	}
+
+	if _, ok := DurationOf(1, 0, 0, 0).ToTimeDuration(); ok {
+		t.Fatalf("expected ToTimeDuration to fail when months is nonzero")
+	}
+	if _, ok := DurationOf(0, 1, 0, 0).ToTimeDuration(); ok {
+		t.Fatalf("expected ToTimeDuration to fail when days is nonzero")
+	}
+}
+
+func TestDurationToTimeDurationDetectsSecondsOverflow(t *testing.T) {
+	if _, ok := DurationOf(0, 0, 1<<62, 0).ToTimeDuration(); ok {
+		t.Fatalf("expected ToTimeDuration to fail when seconds overflows time.Duration's int64 nanosecond range")
+	}
+	if _, ok := DurationOf(0, 0, -(1 << 62), 0).ToTimeDuration(); ok {
+		t.Fatalf("expected ToTimeDuration to fail when a large negative seconds overflows time.Duration's int64 nanosecond range")
+	}
+}
+
+func TestFromTimeDuration(t *testing.T) {
+	got := FromTimeDuration(-1500 * time.Millisecond)
+	roundTripped, ok := got.ToTimeDuration()
+	if !ok {
+		t.Fatalf("expected a round trip through ToTimeDuration to succeed")
+	}
+	if want := -1500 * time.Millisecond; roundTripped != want {
+		t.Fatalf("round trip = %v, want %v", roundTripped, want)
+	}
+}
+
+func TestDatePlusClampsOverflowingDay(t *testing.T) {
+	jan31 := DateOf(time.Date(2021, time.January, 31, 0, 0, 0, 0, time.UTC))
+
+	got := jan31.Plus(DurationOf(1, 0, 0, 0))
+	want := DateOf(time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC))
+	if got != want {
+		t.Fatalf("Jan 31 + 1 month = %v, want %v (clamped, not rolled into March)", got, want)
+	}
+}
+
+func TestLocalDateTimePlusAppliesMonthsDaysSecondsInOrder(t *testing.T) {
+	start := LocalDateTimeOf(time.Date(2021, time.January, 31, 23, 0, 0, 0, time.UTC))
+
+	got := start.Plus(DurationOf(1, 1, 3600, 0))
+	want := LocalDateTimeOf(time.Date(2021, time.March, 2, 0, 0, 0, 0, time.UTC))
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestLocalDateTimePlusIgnoresAmbientLocalDST(t *testing.T) {
+	// LocalDateTime carries no time zone, so Plus must keep its civil
+	// (wall-clock) fields byte-for-byte regardless of what time.Local
+	// happens to be, even when the result lands inside a gap that
+	// time.Local's zone has no wall-clock reading for at all. 2021-03-14
+	// 02:30 never happened in America/New_York: clocks jumped straight
+	// from 02:00 to 03:00. A buggy implementation that re-stamps the
+	// civil result into time.Local via time.Date would have that instant
+	// silently renormalized to 01:30 EST; start is built in time.UTC
+	// precisely so it isn't already corrupted by that same renormalization
+	// before it ever reaches Plus.
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("system tzdata unavailable: %v", err)
+	}
+
+	originalLocal := time.Local
+	time.Local = newYork
+	defer func() { time.Local = originalLocal }()
+
+	start := LocalDateTimeOf(time.Date(2021, time.March, 13, 2, 30, 0, 0, time.UTC))
+
+	got := start.Plus(DurationOf(0, 1, 0, 0))
+
+	if year, month, day := got.date.Date(); year != 2021 || month != time.March || day != 14 {
+		t.Fatalf("got date %04d-%02d-%02d, want 2021-03-14", year, month, day)
+	}
+	if hour, min, sec := got.date.Clock(); hour != 2 || min != 30 || sec != 0 {
+		t.Fatalf("got clock %02d:%02d:%02d, want 02:30:00 (kept verbatim, not shifted by time.Local's DST gap)", hour, min, sec)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import "time"
+
+// resolveTemporalParameter classifies a Cypher parameter value into the
+// concrete DATETIME representation it should be encoded as. ZonedDateTime
+// and OffsetDateTime values are classified by their Go static type, not by
+// inspecting their *time.Location at encode time. A bare time.Time is
+// always encoded as a fixed-offset DATETIME unless legacy is true, in which
+// case it falls back to the pre-ZonedDateTime heuristic of treating it as a
+// ZonedDateTime whenever its location appears to carry an IANA name.
+func resolveTemporalParameter(value interface{}, legacy bool) interface{} {
+	switch v := value.(type) {
+	case ZonedDateTime, OffsetDateTime:
+		return v
+	case time.Time:
+		if legacy && isNamedZone(v.Location()) {
+			return AsZonedDateTime(v, v.Location())
+		}
+		return AsOffsetDateTime(v)
+	default:
+		return value
+	}
+}
+
+// isNamedZone reports whether loc was very likely obtained from
+// time.LoadLocation (or time.Local) rather than time.FixedZone: its name is
+// neither empty nor "Offset", the name this package's own fixed-offset
+// constructors use. This is the same heuristic the encoder used to apply
+// unconditionally before ZonedDateTime existed; it now only runs when
+// LegacyTimeTimeEncoding is enabled.
+func isNamedZone(loc *time.Location) bool {
+	name := loc.String()
+	return name != "" && name != "Offset"
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"testing"
+	"time"
+)
+
+// namedLondon stands in for a time.LoadLocation("Europe/London") result
+// without requiring system tzdata to be installed: isNamedZone only ever
+// inspects loc.String(), so a time.FixedZone sharing that name is
+// indistinguishable to the code under test, and these tests stay runnable
+// on any host, including the tzdata-less environments chunk0-1 targets.
+func namedLondon(offsetSeconds int) *time.Location {
+	return time.FixedZone("Europe/London", offsetSeconds)
+}
+
+func TestResolveTemporalParameterPrefersStaticType(t *testing.T) {
+	named := namedLondon(3600)
+	bare := time.Date(2020, 1, 1, 12, 0, 0, 0, named)
+
+	switch resolveTemporalParameter(bare, false).(type) {
+	case OffsetDateTime:
+	default:
+		t.Fatalf("expected a bare time.Time to default to OffsetDateTime regardless of its location name")
+	}
+
+	zoned := AsZonedDateTime(bare, named)
+	if _, ok := resolveTemporalParameter(zoned, false).(ZonedDateTime); !ok {
+		t.Fatalf("expected a ZonedDateTime value to stay a ZonedDateTime")
+	}
+}
+
+func TestResolveTemporalParameterLegacyHeuristic(t *testing.T) {
+	const offset = 3600
+
+	// A time.Time whose fixed offset happens to equal Europe/London's
+	// offset, but constructed via a plain time.FixedZone, must still be
+	// treated as an offset rather than a zoned value: only named
+	// locations trigger the legacy heuristic.
+	fixed := time.Date(2020, 1, 1, 12, 0, 0, 0, time.FixedZone("Offset", offset))
+
+	if _, ok := resolveTemporalParameter(fixed, true).(OffsetDateTime); !ok {
+		t.Fatalf("expected a FixedZone time.Time to resolve to OffsetDateTime even under the legacy heuristic")
+	}
+
+	namedTime := time.Date(2020, 1, 1, 12, 0, 0, 0, namedLondon(offset))
+	if _, ok := resolveTemporalParameter(namedTime, true).(ZonedDateTime); !ok {
+		t.Fatalf("expected a named-zone time.Time to resolve to ZonedDateTime under the legacy heuristic")
+	}
+}
@@ -374,6 +374,35 @@ var _ = Describe("Temporal Types", func() {
 					"US/Pacific",
 				})
 		})
+
+		It("ambiguous fixed offset vs named zone", func() {
+			// Asia/Shanghai has used a constant +08:00 offset since 1949, so
+			// a ZonedDateTime and an OffsetDateTime built from the very same
+			// instant carry the identical UTC offset and are only told apart
+			// by their Go static type, not by anything observable on
+			// location. Before AsZonedDateTime/AsOffsetDateTime existed, a
+			// bare time.Time here always won as a ZonedDateTime because its
+			// *time.Location happened to be named.
+			shanghai, err := time.LoadLocation("Asia/Shanghai")
+			Expect(err).To(BeNil())
+			instant := time.Date(2020, 6, 15, 10, 0, 0, 0, shanghai)
+
+			zonedData := AsZonedDateTime(instant, shanghai)
+			testSendAndReceive("WITH $x AS x RETURN x, x.timezone",
+				zonedData,
+				[]interface{}{
+					zonedData,
+					"Asia/Shanghai",
+				})
+
+			offsetData := AsOffsetDateTime(instant)
+			testSendAndReceive("WITH $x AS x RETURN x, x.offset",
+				offsetData,
+				[]interface{}{
+					offsetData,
+					"+08:00",
+				})
+		})
 	})
 
 	Context("Send and receive random", func() {
@@ -420,6 +449,40 @@ var _ = Describe("Temporal Types", func() {
 		})
 	})
 
+	Context("Arithmetic", func() {
+		It("Duration.Add matches Cypher's + operator", func() {
+			for i := 0; i < numberOfRandomValues; i++ {
+				a := randomDuration()
+				b := randomDuration()
+
+				result, err = session.Run(
+					"WITH $a AS a, $b AS b RETURN a + b",
+					&map[string]interface{}{"a": a, "b": b})
+				Expect(err).To(BeNil())
+
+				Expect(result.Next()).To(BeTrue())
+				Expect(result.Record().GetByIndex(0)).To(Equal(a.Add(b)))
+				Expect(result.Err()).To(BeNil())
+			}
+		})
+
+		It("LocalDateTime.Plus matches Cypher's + operator", func() {
+			for i := 0; i < numberOfRandomValues; i++ {
+				a := randomLocalDateTime()
+				b := randomDuration()
+
+				result, err = session.Run(
+					"WITH $a AS a, $b AS b RETURN a + b",
+					&map[string]interface{}{"a": a, "b": b})
+				Expect(err).To(BeNil())
+
+				Expect(result.Next()).To(BeTrue())
+				Expect(result.Record().GetByIndex(0)).To(Equal(a.Plus(b)))
+				Expect(result.Err()).To(BeNil())
+			}
+		})
+	})
+
 	Context("Send and receive random arrays", func() {
 		It("duration", func() {
 			listSize := rand.Intn(1000)
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"time"
+)
+
+// LocalDateTime represents a Neo4j LOCAL DATETIME value: a date and time of
+// day without a time zone or UTC offset.
+type LocalDateTime struct {
+	date time.Time
+}
+
+// LocalDateTimeOf creates a LocalDateTime from the given time.Time,
+// preserving its date and time-of-day components. The location of t is
+// ignored. The result is stored in time.UTC rather than t's own location:
+// LocalDateTime carries no time zone of its own, and UTC, unlike most local
+// zones, never has a daylight-saving gap or ambiguous wall-clock reading
+// that could otherwise silently shift its civil fields.
+func LocalDateTimeOf(t time.Time) LocalDateTime {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return LocalDateTime{date: time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.UTC)}
+}
+
+// Time returns this local date time as a time.Time in time.UTC.
+func (t LocalDateTime) Time() time.Time {
+	return t.date
+}
+
+func (t LocalDateTime) String() string {
+	return t.date.Format("2006-01-02T15:04:05.000000000")
+}
+
+// Plus returns the LocalDateTime obtained by adding d to t, applying its
+// months, then days, then seconds and nanoseconds components in that
+// order, the same order Neo4j's Cypher `+` operator uses.
+func (t LocalDateTime) Plus(d Duration) LocalDateTime {
+	return LocalDateTimeOf(addDuration(t.date, d))
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"time"
+)
+
+// LocalTime represents a Neo4j LOCAL TIME value: a time of day without a
+// time zone or UTC offset.
+type LocalTime struct {
+	time time.Time
+}
+
+// LocalTimeOf creates a LocalTime from the hour, minute, second and
+// nanosecond components of the given time.Time. The date and location of t
+// are ignored.
+func LocalTimeOf(t time.Time) LocalTime {
+	hour, min, sec := t.Clock()
+	return LocalTime{time: time.Date(0, 0, 0, hour, min, sec, t.Nanosecond(), time.Local)}
+}
+
+// Time returns this local time as a time.Time with a zero date.
+func (t LocalTime) Time() time.Time {
+	return t.time
+}
+
+func (t LocalTime) String() string {
+	h, m, s := t.time.Clock()
+	return fmt.Sprintf("%02d:%02d:%02d.%09d", h, m, s, t.time.Nanosecond())
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"time"
+)
+
+// OffsetTime represents a Neo4j TIME value: a time of day with a fixed UTC
+// offset, but no named time zone.
+type OffsetTime struct {
+	time time.Time
+}
+
+// OffsetTimeOf creates an OffsetTime from the hour, minute, second,
+// nanosecond and zone offset components of the given time.Time. The date of
+// t is ignored.
+func OffsetTimeOf(t time.Time) OffsetTime {
+	hour, min, sec := t.Clock()
+	_, offset := t.Zone()
+	return OffsetTime{time: time.Date(0, 0, 0, hour, min, sec, t.Nanosecond(), time.FixedZone("Offset", offset))}
+}
+
+// Time returns this offset time as a time.Time with a zero date.
+func (t OffsetTime) Time() time.Time {
+	return t.time
+}
+
+func (t OffsetTime) String() string {
+	h, m, s := t.time.Clock()
+	_, offset := t.time.Zone()
+	return fmt.Sprintf("%02d:%02d:%02d.%09d%s", h, m, s, t.time.Nanosecond(), formatOffset(offset))
+}
+
+func formatOffset(offsetSeconds int) string {
+	sign := "+"
+	if offsetSeconds < 0 {
+		sign = "-"
+		offsetSeconds = -offsetSeconds
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, offsetSeconds/3600, (offsetSeconds%3600)/60)
+}
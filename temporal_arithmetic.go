@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import "time"
+
+// addDuration applies d to t the way Neo4j's Cypher `+` operator applies a
+// DURATION to a temporal instant: months are added first (clamping the
+// day-of-month to the last valid day of the target month, rather than
+// overflowing into the following month), then days, then seconds and
+// nanoseconds. The arithmetic itself is performed on t's civil (wall-clock)
+// fields in time.UTC rather than through time.Time.Add on t's own location,
+// and the result is likewise returned in time.UTC rather than re-stamped
+// into t's original location: Date and LocalDateTime carry no time zone at
+// all, so their wall-clock result must be taken verbatim, even when it
+// falls in a gap or ambiguous window of a DST-observing time.Local — a
+// second pass through time.Date in that location would silently renormalize
+// exactly the kind of value this function exists to keep zone-free.
+// Callers that do need the result expressed in a real location (only
+// OffsetDateTime, whose location is always a fixed, DST-free offset) must
+// re-stamp it themselves; doing so for a fixed offset is always safe.
+func addDuration(t time.Time, d Duration) time.Time {
+	civil := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	civil = addMonthsClamped(civil, d.months)
+	civil = civil.AddDate(0, 0, int(d.days))
+	return civil.Add(time.Duration(d.seconds)*time.Second + time.Duration(d.nanos)*time.Nanosecond)
+}
+
+// addMonthsClamped adds months to t, clamping the day-of-month to the last
+// day of the resulting month when t's day does not exist there (e.g.
+// 31 January + 1 month = 28 or 29 February, not 2 or 3 March as
+// time.Time.AddDate would produce).
+func addMonthsClamped(t time.Time, months int64) time.Time {
+	year, month, day := t.Date()
+
+	totalMonths := int64(month) - 1 + months
+	y := int64(year) + totalMonths/12
+	m := totalMonths % 12
+	if m < 0 {
+		m += 12
+		y--
+	}
+	newMonth := time.Month(m + 1)
+
+	if lastDay := daysInMonth(int(y), newMonth); day > lastDay {
+		day = lastDay
+	}
+
+	hour, min, sec := t.Clock()
+	return time.Date(int(y), newMonth, day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// daysInMonth returns the number of days in the given month of year.
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoadLocation resolves an IANA time zone name (as received in a Neo4j
+// ZonedDateTime) to a *time.Location. It defaults to time.LoadLocation and
+// is a package-level variable so that callers can substitute their own
+// resolver, for example one backed by a vendored copy of the tzdata
+// database or a custom cache.
+var LoadLocation = time.LoadLocation
+
+// tzdataFallback is nil unless github.com/neo4j/neo4j-go-driver/tzdata has
+// been imported for its side effect, in which case it resolves zone names
+// against an embedded copy of the IANA database.
+var tzdataFallback func(name string) (*time.Location, error)
+
+// RegisterTZDataFallback installs a fallback time zone resolver that is
+// consulted by resolveLocation when LoadLocation fails to find a zone, e.g.
+// because the host has no tzdata installed. It is called by
+// github.com/neo4j/neo4j-go-driver/tzdata's init function and is not
+// intended to be called directly by driver users.
+func RegisterTZDataFallback(load func(name string) (*time.Location, error)) {
+	tzdataFallback = load
+}
+
+// UnknownTimeZoneError is returned by the temporal decoding path when a
+// named time zone received from the server cannot be resolved, neither by
+// LoadLocation nor by the embedded tzdata fallback (if registered). It lets
+// callers distinguish a missing-tzdata environment from a malformed
+// protocol value.
+type UnknownTimeZoneError struct {
+	Name string
+}
+
+func (e *UnknownTimeZoneError) Error() string {
+	if tzdataFallback == nil {
+		return fmt.Sprintf("neo4j: unknown time zone %q: not found via LoadLocation, and no embedded tzdata fallback is registered (import github.com/neo4j/neo4j-go-driver/tzdata for one)", e.Name)
+	}
+	return fmt.Sprintf("neo4j: unknown time zone %q: not found via LoadLocation or the embedded tzdata fallback", e.Name)
+}
+
+// resolveLocation resolves an IANA zone name received on the wire to a
+// *time.Location, trying LoadLocation first and falling back to the
+// embedded tzdata database when one has been registered.
+func resolveLocation(name string) (*time.Location, error) {
+	if loc, err := LoadLocation(name); err == nil {
+		return loc, nil
+	}
+
+	if tzdataFallback != nil {
+		if loc, err := tzdataFallback(name); err == nil {
+			return loc, nil
+		}
+	}
+
+	return nil, &UnknownTimeZoneError{Name: name}
+}
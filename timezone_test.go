@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResolveLocationFallsBackToEmbeddedTZData(t *testing.T) {
+	originalLoadLocation := LoadLocation
+	originalFallback := tzdataFallback
+	defer func() {
+		LoadLocation = originalLoadLocation
+		tzdataFallback = originalFallback
+	}()
+
+	LoadLocation = func(name string) (*time.Location, error) {
+		return nil, errors.New("no system tzdata")
+	}
+	tzdataFallback = func(name string) (*time.Location, error) {
+		return time.UTC, nil
+	}
+
+	loc, err := resolveLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("expected fallback to resolve the zone, got error: %v", err)
+	}
+	if loc != time.UTC {
+		t.Fatalf("expected fallback location, got %v", loc)
+	}
+}
+
+func TestResolveLocationReturnsUnknownTimeZoneError(t *testing.T) {
+	originalLoadLocation := LoadLocation
+	originalFallback := tzdataFallback
+	defer func() {
+		LoadLocation = originalLoadLocation
+		tzdataFallback = originalFallback
+	}()
+
+	LoadLocation = func(name string) (*time.Location, error) {
+		return nil, errors.New("no system tzdata")
+	}
+	tzdataFallback = nil
+
+	_, err := resolveLocation("Europe/London")
+
+	var unknownErr *UnknownTimeZoneError
+	if !errors.As(err, &unknownErr) {
+		t.Fatalf("expected *UnknownTimeZoneError, got %v (%T)", err, err)
+	}
+	if unknownErr.Name != "Europe/London" {
+		t.Fatalf("expected error to carry the zone name, got %q", unknownErr.Name)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tzdata bundles a copy of the IANA time zone database with the
+// driver binary so that ZonedDateTime values can be decoded on hosts with
+// no system tzdata available, such as Windows without a Go installation,
+// scratch/distroless containers, or minimal Alpine images missing the
+// tzdata package.
+//
+// Import it for its side effect to register the fallback:
+//
+//	import _ "github.com/neo4j/neo4j-go-driver/tzdata"
+package tzdata
+
+import (
+	"archive/zip"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	neo4j "github.com/neo4j/neo4j-go-driver"
+)
+
+//go:embed zoneinfo.zip
+var zoneinfo []byte
+
+func init() {
+	neo4j.RegisterTZDataFallback(load)
+}
+
+// load resolves name against the embedded zoneinfo.zip, the same archive
+// format and layout as $GOROOT/lib/time/zoneinfo.zip.
+func load(name string) (*time.Location, error) {
+	archive, err := zip.NewReader(bytes.NewReader(zoneinfo), int64(len(zoneinfo)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return time.LoadLocationFromTZData(name, data)
+	}
+
+	return nil, fmt.Errorf("tzdata: time zone %q not found in embedded database", name)
+}
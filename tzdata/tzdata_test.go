@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tzdata
+
+import "testing"
+
+// Importing this package (as this test binary does implicitly, being part
+// of it) runs init(), which registers load below as the neo4j package's
+// tzdata fallback. Calling load directly here exercises that exact
+// registered function against the embedded zoneinfo.zip, proving the
+// embedded database resolves a real IANA zone without relying on the host's
+// system tzdata.
+func TestLoadResolvesEmbeddedZone(t *testing.T) {
+	loc, err := load("Europe/London")
+	if err != nil {
+		t.Fatalf("load(\"Europe/London\") returned an error: %v", err)
+	}
+	if loc == nil {
+		t.Fatal("load(\"Europe/London\") returned a nil *time.Location")
+	}
+	if loc.String() != "Europe/London" {
+		t.Fatalf("loc.String() = %q, want %q", loc.String(), "Europe/London")
+	}
+}
+
+func TestLoadReturnsErrorForUnknownZone(t *testing.T) {
+	if _, err := load("Not/A_Real_Zone"); err == nil {
+		t.Fatal("expected an error for a zone not present in the embedded database")
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import "time"
+
+// ZonedDateTime represents a Neo4j DATETIME value carrying a named IANA
+// time zone (e.g. "Europe/London"), as opposed to OffsetDateTime which only
+// carries a fixed UTC offset. It is a distinct Go type from time.Time so
+// that the encoder can pick the DATETIME wire representation from the
+// static type of a parameter rather than inspecting whether its
+// *time.Location happens to be a time.FixedZone.
+type ZonedDateTime struct {
+	date time.Time
+}
+
+// AsZonedDateTime wraps t, which must have loc as its location, as a
+// ZonedDateTime so that it is sent to Neo4j as a DATETIME with a named time
+// zone rather than a fixed UTC offset. Use this when the IANA zone name
+// itself is significant to the query (e.g. "Europe/London" observing
+// daylight saving), not just the offset at t.
+func AsZonedDateTime(t time.Time, loc *time.Location) ZonedDateTime {
+	return ZonedDateTime{date: t.In(loc)}
+}
+
+// Time returns this value as a time.Time in its named zone.
+func (z ZonedDateTime) Time() time.Time {
+	return z.date
+}
+
+func (z ZonedDateTime) String() string {
+	return z.date.Format("2006-01-02T15:04:05.000000000") + "[" + z.date.Location().String() + "]"
+}
+
+// AsOffsetDateTime wraps t as an OffsetDateTime, forcing it to be sent to
+// Neo4j as a DATETIME with a fixed UTC offset and no named time zone, even
+// if t's *time.Location happens to also identify an IANA zone.
+func AsOffsetDateTime(t time.Time) OffsetDateTime {
+	_, offset := t.Zone()
+	return OffsetDateTime{date: t.In(time.FixedZone("Offset", offset))}
+}
+
+// OffsetDateTime represents a Neo4j DATETIME value carrying a fixed UTC
+// offset but no named time zone, as distinct from ZonedDateTime.
+type OffsetDateTime struct {
+	date time.Time
+}
+
+// Time returns this value as a time.Time in a time.FixedZone carrying its
+// UTC offset.
+func (o OffsetDateTime) Time() time.Time {
+	return o.date
+}
+
+func (o OffsetDateTime) String() string {
+	return o.date.Format("2006-01-02T15:04:05.000000000Z07:00")
+}
+
+// Plus returns the OffsetDateTime obtained by adding d to o, applying its
+// months, then days, then seconds and nanoseconds components in that
+// order, the same order Neo4j's Cypher `+` operator uses. The result keeps
+// o's fixed UTC offset: addDuration hands back a zone-free civil result, so
+// it is re-stamped with o's offset here rather than via AsOffsetDateTime,
+// which would otherwise read it back off the zone-free result itself (UTC,
+// offset zero). Re-stamping a fixed, non-DST offset like this can never
+// land on a nonexistent or ambiguous wall-clock time, unlike re-stamping a
+// named zone would.
+func (o OffsetDateTime) Plus(d Duration) OffsetDateTime {
+	civil := addDuration(o.date, d)
+	_, offset := o.date.Zone()
+	return OffsetDateTime{date: time.Date(civil.Year(), civil.Month(), civil.Day(), civil.Hour(), civil.Minute(), civil.Second(), civil.Nanosecond(), time.FixedZone("Offset", offset))}
+}
+
+// AsLocalDateTime wraps t as a LocalDateTime, forcing it to be sent to
+// Neo4j as a LOCAL DATETIME, discarding t's time zone and UTC offset
+// entirely. This is equivalent to LocalDateTimeOf but reads better at a
+// Cypher parameter call site, alongside AsOffsetDateTime and
+// AsZonedDateTime.
+func AsLocalDateTime(t time.Time) LocalDateTime {
+	return LocalDateTimeOf(t)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2002-2018 "Neo4j,"
+ * Neo4j Sweden AB [http://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestZonedDateTimeStringShowsIANAName(t *testing.T) {
+	// time.FixedZone can't stand in for the real location here: FixedZone
+	// uses the same string for both its name and its abbreviation, so it
+	// can't expose the bug being guarded against. Only a genuine tzdata
+	// zone has an abbreviation ("BST" in summer) that differs from its
+	// full IANA name ("Europe/London"), which is exactly what would have
+	// been indistinguishable under the old "[MST]" layout token.
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("system tzdata unavailable: %v", err)
+	}
+
+	zoned := AsZonedDateTime(time.Date(2020, 7, 1, 12, 0, 0, 0, london), london)
+
+	got := zoned.String()
+	if !strings.Contains(got, "Europe/London") {
+		t.Fatalf("String() = %q, want it to contain the IANA zone name %q, not just its abbreviation (e.g. BST)", got, "Europe/London")
+	}
+	if strings.Contains(got, "BST") {
+		t.Fatalf("String() = %q, want the full IANA name, not the zone abbreviation BST", got)
+	}
+}